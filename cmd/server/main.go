@@ -0,0 +1,29 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/vdshakh/summer-2022-practice-task/schedule"
+	"github.com/vdshakh/summer-2022-practice-task/server"
+)
+
+const (
+	addr         = ":8080"
+	scheduleFile = "data.json"
+)
+
+func main() {
+	sched, err := schedule.LoadSchedule(scheduleFile)
+	if err != nil {
+		log.Fatalf("load schedule: %v", err)
+	}
+
+	schedule.SetLoader(sched)
+
+	log.Printf("listening on %v", addr)
+
+	if err := http.ListenAndServe(addr, server.NewRouter()); err != nil {
+		log.Fatalf("server failed: %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+package schedule
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// Weekday bits compose ServiceDays.Weekdays, mirroring GTFS calendar.txt's
+// one-flag-per-weekday columns.
+const (
+	Monday uint8 = 1 << iota
+	Tuesday
+	Wednesday
+	Thursday
+	Friday
+	Saturday
+	Sunday
+)
+
+var weekdayBits = map[time.Weekday]uint8{
+	time.Monday:    Monday,
+	time.Tuesday:   Tuesday,
+	time.Wednesday: Wednesday,
+	time.Thursday:  Thursday,
+	time.Friday:    Friday,
+	time.Saturday:  Saturday,
+	time.Sunday:    Sunday,
+}
+
+var weekdayNames = map[string]uint8{
+	"monday":    Monday,
+	"tuesday":   Tuesday,
+	"wednesday": Wednesday,
+	"thursday":  Thursday,
+	"friday":    Friday,
+	"saturday":  Saturday,
+	"sunday":    Sunday,
+}
+
+// ServiceDays says which calendar dates a train runs on: a weekly pattern
+// plus explicit exceptions, the same split GTFS makes between calendar.txt
+// and calendar_dates.txt.
+type ServiceDays struct {
+	Weekdays     uint8
+	IncludeDates map[string]struct{}
+	ExcludeDates map[string]struct{}
+}
+
+// Runs reports whether the service operates on date. The zero ServiceDays
+// (no weekly pattern, no exceptions) always runs, so trains loaded from
+// feeds without calendar data keep matching every date.
+func (s ServiceDays) Runs(date time.Time) bool {
+	key := date.Format(dateLayout)
+
+	if _, excluded := s.ExcludeDates[key]; excluded {
+		return false
+	}
+
+	if _, included := s.IncludeDates[key]; included {
+		return true
+	}
+
+	if s.Weekdays == 0 {
+		return true
+	}
+
+	return s.Weekdays&weekdayBits[date.Weekday()] != 0
+}
+
+type serviceDaysJSON struct {
+	Weekdays     []string `json:"weekdays,omitempty"`
+	IncludeDates []string `json:"includeDates,omitempty"`
+	ExcludeDates []string `json:"excludeDates,omitempty"`
+}
+
+// UnmarshalJSON accepts weekday names plus explicit date exceptions, e.g.
+// {"weekdays": ["monday","tuesday"], "excludeDates": ["2026-01-01"]}.
+func (s *ServiceDays) UnmarshalJSON(b []byte) error {
+	var aux serviceDaysJSON
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	for _, name := range aux.Weekdays {
+		s.Weekdays |= weekdayNames[strings.ToLower(name)]
+	}
+
+	s.IncludeDates = toDateSet(aux.IncludeDates)
+	s.ExcludeDates = toDateSet(aux.ExcludeDates)
+
+	return nil
+}
+
+// MarshalJSON mirrors UnmarshalJSON's shape so a ServiceDays round-trips
+// through JSON the same way it came in.
+func (s ServiceDays) MarshalJSON() ([]byte, error) {
+	var aux serviceDaysJSON
+
+	for name, bit := range weekdayNames {
+		if s.Weekdays&bit != 0 {
+			aux.Weekdays = append(aux.Weekdays, name)
+		}
+	}
+
+	aux.IncludeDates = fromDateSet(s.IncludeDates)
+	aux.ExcludeDates = fromDateSet(s.ExcludeDates)
+
+	return json.Marshal(aux)
+}
+
+func toDateSet(dates []string) map[string]struct{} {
+	if len(dates) == 0 {
+		return nil
+	}
+
+	set := make(map[string]struct{}, len(dates))
+	for _, d := range dates {
+		set[d] = struct{}{}
+	}
+
+	return set
+}
+
+func fromDateSet(set map[string]struct{}) []string {
+	if len(set) == 0 {
+		return nil
+	}
+
+	dates := make([]string, 0, len(set))
+	for d := range set {
+		dates = append(dates, d)
+	}
+
+	return dates
+}
@@ -0,0 +1,76 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceDaysRuns(t *testing.T) {
+	monday := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+
+	assert.True(t, ServiceDays{}.Runs(monday), "zero ServiceDays always runs")
+
+	weekdaysOnly := ServiceDays{Weekdays: Monday}
+	assert.True(t, weekdaysOnly.Runs(monday))
+	assert.False(t, weekdaysOnly.Runs(tuesday))
+
+	withException := ServiceDays{
+		Weekdays:     Monday,
+		ExcludeDates: map[string]struct{}{"2026-01-05": {}},
+		IncludeDates: map[string]struct{}{"2026-01-06": {}},
+	}
+	assert.False(t, withException.Runs(monday), "excluded date overrides the weekly pattern")
+	assert.True(t, withException.Runs(tuesday), "included date overrides the weekly pattern")
+}
+
+func TestFindTrainsFiltersByDate(t *testing.T) {
+	prevLoader := activeLoader
+	defer SetLoader(prevLoader)
+
+	monday := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+
+	mondayOnly := Train{TrainID: 1, DepartureStationID: 1, ArrivalStationID: 2,
+		ServiceDays: ServiceDays{Weekdays: Monday}}
+	everyDay := Train{TrainID: 2, DepartureStationID: 1, ArrivalStationID: 2}
+
+	SetLoader(staticLoader{mondayOnly, everyDay})
+
+	result, err := FindTrains("1", "2", "price", monday)
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+
+	result, err = FindTrains("1", "2", "price", tuesday)
+	assert.NoError(t, err)
+	assert.Equal(t, Trains{everyDay}, result)
+}
+
+func TestTrainUnmarshalJSONRollsOvernightArrival(t *testing.T) {
+	var tr Train
+
+	err := tr.UnmarshalJSON([]byte(`{
+		"trainId": 1,
+		"departureStationId": 1,
+		"arrivalStationId": 2,
+		"price": 10,
+		"departureTime": "23:50:00",
+		"arrivalTime": "00:40:00"
+	}`))
+	assert.NoError(t, err)
+	assert.True(t, tr.ArrivalTime.After(tr.DepartureTime))
+	assert.Equal(t, 2, tr.ArrivalTime.Day())
+}
+
+func TestCustomTimeUnmarshalJSONRFC3339Fallback(t *testing.T) {
+	var ct customTime
+
+	err := ct.UnmarshalJSON([]byte(`"2026-01-05T08:30:00Z"`))
+	assert.NoError(t, err)
+
+	tm := time.Time(ct)
+	assert.Equal(t, 2026, tm.Year())
+	assert.Equal(t, 8, tm.Hour())
+}
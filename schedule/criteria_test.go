@@ -0,0 +1,47 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindTrainsPareto(t *testing.T) {
+	prevLoader := activeLoader
+	defer SetLoader(prevLoader)
+
+	cheapButLate := Train{TrainID: 1, DepartureStationID: 1, ArrivalStationID: 2, Price: 50,
+		DepartureTime: time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC),
+		ArrivalTime:   time.Date(0, 1, 1, 12, 0, 0, 0, time.UTC)}
+	fastButExpensive := Train{TrainID: 2, DepartureStationID: 1, ArrivalStationID: 2, Price: 100,
+		DepartureTime: time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC),
+		ArrivalTime:   time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC)}
+	dominated := Train{TrainID: 3, DepartureStationID: 1, ArrivalStationID: 2, Price: 100,
+		DepartureTime: time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC),
+		ArrivalTime:   time.Date(0, 1, 1, 12, 0, 0, 0, time.UTC)}
+
+	SetLoader(staticLoader{cheapButLate, fastButExpensive, dominated})
+
+	result, err := FindTrains("1", "2", "pareto", time.Time{})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, Trains{cheapButLate, fastButExpensive}, result)
+}
+
+func TestFindTrainsLexicographicCriteria(t *testing.T) {
+	prevLoader := activeLoader
+	defer SetLoader(prevLoader)
+
+	cheaperLaterArrival := Train{TrainID: 1, DepartureStationID: 1, ArrivalStationID: 2, Price: 50,
+		ArrivalTime: time.Date(0, 1, 1, 12, 0, 0, 0, time.UTC)}
+	samePriceEarlierArrival := Train{TrainID: 2, DepartureStationID: 1, ArrivalStationID: 2, Price: 50,
+		ArrivalTime: time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC)}
+	pricier := Train{TrainID: 3, DepartureStationID: 1, ArrivalStationID: 2, Price: 75,
+		ArrivalTime: time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC)}
+
+	SetLoader(staticLoader{cheaperLaterArrival, pricier, samePriceEarlierArrival})
+
+	result, err := FindTrains("1", "2", "price,arrival-time", time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, Trains{samePriceEarlierArrival, cheaperLaterArrival, pricier}, result)
+}
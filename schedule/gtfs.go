@@ -0,0 +1,375 @@
+package schedule
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GTFSLoader builds a schedule from a GTFS static feed (a zip archive
+// containing stops.txt, trips.txt, stop_times.txt and calendar.txt), so
+// any agency publishing a standard feed can be searched the same way as
+// the original data.json.
+//
+// Each consecutive pair of stops visited by a trip becomes one Train: its
+// departure/arrival station and times come straight from stop_times.txt.
+// GTFS carries no fare data in these files, so Price is left at zero.
+type GTFSLoader struct {
+	Path string
+}
+
+type gtfsStopTime struct {
+	stopID        int
+	sequence      int
+	arrivalTime   time.Time
+	departureTime time.Time
+}
+
+// Load implements Loader.
+func (l GTFSLoader) Load() (Trains, error) {
+	archive, err := zip.OpenReader(l.Path)
+	if err != nil {
+		return nil, fmt.Errorf("open gtfs feed: %w", err)
+	}
+
+	defer archive.Close()
+
+	files := map[string]*zip.File{}
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	stopIDs, err := readStops(files["stops.txt"])
+	if err != nil {
+		return nil, fmt.Errorf("read stops.txt: %w", err)
+	}
+
+	services, err := readCalendar(files["calendar.txt"])
+	if err != nil {
+		return nil, fmt.Errorf("read calendar.txt: %w", err)
+	}
+
+	tripServiceDays, err := readTrips(files["trips.txt"], services)
+	if err != nil {
+		return nil, fmt.Errorf("read trips.txt: %w", err)
+	}
+
+	stopTimes, err := readStopTimes(files["stop_times.txt"], stopIDs, tripServiceDays)
+	if err != nil {
+		return nil, fmt.Errorf("read stop_times.txt: %w", err)
+	}
+
+	return buildTrains(stopTimes, tripServiceDays), nil
+}
+
+// readStops parses stops.txt into a stop_id -> numeric station ID map and
+// populates the package-wide stationIndex so station names can be
+// resolved by FindTrains.
+func readStops(f *zip.File) (map[string]int, error) {
+	records, err := readCSVFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	idCol, nameCol, err := columnIndexes(records, "stop_id", "stop_name")
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[string]int{}
+	nextID := 1
+
+	for _, row := range records[1:] {
+		rawID, name := row[idCol], row[nameCol]
+
+		id, err := strconv.Atoi(rawID)
+		if err != nil {
+			id = nextID
+			nextID++
+		}
+
+		ids[rawID] = id
+		stationIndex[strings.ToLower(name)] = id
+	}
+
+	return ids, nil
+}
+
+// readCalendar parses calendar.txt into a service_id -> ServiceDays map,
+// turning the monday..sunday flag columns into the same Weekdays bitmask
+// ServiceDays uses everywhere else.
+func readCalendar(f *zip.File) (map[string]ServiceDays, error) {
+	records, err := readCSVFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	idCol, err := columnIndex(records, "service_id")
+	if err != nil {
+		return nil, err
+	}
+
+	weekdayCols := make(map[string]int, len(weekdayNames))
+
+	for name := range weekdayNames {
+		col, err := columnIndex(records, name)
+		if err != nil {
+			return nil, err
+		}
+
+		weekdayCols[name] = col
+	}
+
+	services := map[string]ServiceDays{}
+
+	for _, row := range records[1:] {
+		var days ServiceDays
+
+		for name, col := range weekdayCols {
+			if row[col] == "1" {
+				days.Weekdays |= weekdayNames[name]
+			}
+		}
+
+		services[row[idCol]] = days
+	}
+
+	return services, nil
+}
+
+// readTrips parses trips.txt into a trip_id -> ServiceDays map, dropping
+// trips that reference a service absent from calendar.txt.
+func readTrips(f *zip.File, knownServices map[string]ServiceDays) (map[string]ServiceDays, error) {
+	records, err := readCSVFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	tripCol, serviceCol, err := columnIndexes(records, "trip_id", "service_id")
+	if err != nil {
+		return nil, err
+	}
+
+	trips := map[string]ServiceDays{}
+
+	for _, row := range records[1:] {
+		tripID, serviceID := row[tripCol], row[serviceCol]
+
+		days, ok := knownServices[serviceID]
+		if !ok {
+			continue
+		}
+
+		trips[tripID] = days
+	}
+
+	return trips, nil
+}
+
+// readStopTimes parses stop_times.txt into the ordered stop visits of
+// every known trip.
+func readStopTimes(f *zip.File, stopIDs map[string]int, knownTrips map[string]ServiceDays) (map[string][]gtfsStopTime, error) {
+	records, err := readCSVFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	tripCol, stopCol, seqCol, arrCol, depCol, err := stopTimeColumns(records)
+	if err != nil {
+		return nil, err
+	}
+
+	byTrip := map[string][]gtfsStopTime{}
+
+	for _, row := range records[1:] {
+		tripID := row[tripCol]
+		if _, ok := knownTrips[tripID]; !ok {
+			continue
+		}
+
+		sequence, err := strconv.Atoi(row[seqCol])
+		if err != nil {
+			return nil, fmt.Errorf("bad stop_sequence %q: %w", row[seqCol], err)
+		}
+
+		arrival, err := parseGTFSTime(row[arrCol])
+		if err != nil {
+			return nil, fmt.Errorf("bad arrival_time %q: %w", row[arrCol], err)
+		}
+
+		departure, err := parseGTFSTime(row[depCol])
+		if err != nil {
+			return nil, fmt.Errorf("bad departure_time %q: %w", row[depCol], err)
+		}
+
+		stopID, ok := stopIDs[row[stopCol]]
+		if !ok {
+			return nil, fmt.Errorf("unknown stop_id %q", row[stopCol])
+		}
+
+		byTrip[tripID] = append(byTrip[tripID], gtfsStopTime{
+			stopID:        stopID,
+			sequence:      sequence,
+			arrivalTime:   arrival,
+			departureTime: departure,
+		})
+	}
+
+	for _, stops := range byTrip {
+		sort.Slice(stops, func(i, j int) bool { return stops[i].sequence < stops[j].sequence })
+	}
+
+	return byTrip, nil
+}
+
+// buildTrains joins each trip's consecutive stop visits into Train legs,
+// tagging each with the ServiceDays resolved from its trip's calendar
+// entry so Date-filtered FindTrains queries apply to GTFS feeds too.
+func buildTrains(byTrip map[string][]gtfsStopTime, tripServiceDays map[string]ServiceDays) Trains {
+	var result Trains
+
+	trainID := 1
+
+	tripIDs := make([]string, 0, len(byTrip))
+	for tripID := range byTrip {
+		tripIDs = append(tripIDs, tripID)
+	}
+
+	sort.Strings(tripIDs)
+
+	for _, tripID := range tripIDs {
+		stops := byTrip[tripID]
+		for i := 0; i+1 < len(stops); i++ {
+			from, to := stops[i], stops[i+1]
+
+			result = append(result, Train{
+				TrainID:            trainID,
+				DepartureStationID: from.stopID,
+				ArrivalStationID:   to.stopID,
+				Price:              0,
+				DepartureTime:      from.departureTime,
+				ArrivalTime:        to.arrivalTime,
+				ServiceDays:        tripServiceDays[tripID],
+			})
+			trainID++
+		}
+	}
+
+	return result
+}
+
+// parseGTFSTime parses a GTFS HH:MM:SS time, which may exceed 24:00:00 for
+// trips that run past midnight; time.Date rolls that over to the next day.
+func parseGTFSTime(s string) (time.Time, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("expected HH:MM:SS, got %q", s)
+	}
+
+	hour, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	minute, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	second, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(0, time.January, 1, hour, minute, second, 0, time.UTC), nil
+}
+
+func readCSVFile(f *zip.File) ([][]string, error) {
+	if f == nil {
+		return nil, fmt.Errorf("file missing from feed")
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rc.Close()
+
+	reader := csv.NewReader(rc)
+	reader.TrimLeadingSpace = true
+
+	var records [][]string
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, row)
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("empty file")
+	}
+
+	return records, nil
+}
+
+func columnIndex(records [][]string, name string) (int, error) {
+	for i, col := range records[0] {
+		if col == name {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("column %q not found", name)
+}
+
+func columnIndexes(records [][]string, names ...string) (int, int, error) {
+	first, err := columnIndex(records, names[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	second, err := columnIndex(records, names[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return first, second, nil
+}
+
+func stopTimeColumns(records [][]string) (trip, stop, seq, arr, dep int, err error) {
+	if trip, err = columnIndex(records, "trip_id"); err != nil {
+		return
+	}
+
+	if stop, err = columnIndex(records, "stop_id"); err != nil {
+		return
+	}
+
+	if seq, err = columnIndex(records, "stop_sequence"); err != nil {
+		return
+	}
+
+	if arr, err = columnIndex(records, "arrival_time"); err != nil {
+		return
+	}
+
+	if dep, err = columnIndex(records, "departure_time"); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,74 @@
+package schedule
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGTFSLoaderLoad(t *testing.T) {
+	loader := GTFSLoader{Path: "testdata/sample_feed.zip"}
+
+	trains, err := loader.Load()
+	assert.NoError(t, err)
+	assert.Len(t, trains, 1)
+
+	assert.Equal(t, 1, trains[0].DepartureStationID)
+	assert.Equal(t, 2, trains[0].ArrivalStationID)
+	assert.Equal(t, 8*60+5, trains[0].DepartureTime.Hour()*60+trains[0].DepartureTime.Minute())
+	assert.Equal(t, 11*60+30, trains[0].ArrivalTime.Hour()*60+trains[0].ArrivalTime.Minute())
+
+	assert.Equal(t, Monday|Tuesday|Wednesday|Thursday|Friday, trains[0].ServiceDays.Weekdays)
+
+	assert.Equal(t, 1, stationIndex["berlin hbf"])
+	assert.Equal(t, 2, stationIndex["munich hbf"])
+}
+
+func TestGTFSLoaderLoadRejectsUnknownStopID(t *testing.T) {
+	path := writeGTFSFeed(t, map[string]string{
+		"stops.txt":      "stop_id,stop_name\n1,Berlin Hbf\n",
+		"calendar.txt":   "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday\nS1,1,1,1,1,1,0,0\n",
+		"trips.txt":      "trip_id,service_id\nT1,S1\n",
+		"stop_times.txt": "trip_id,stop_id,stop_sequence,arrival_time,departure_time\nT1,1,1,08:00:00,08:05:00\nT1,99,2,11:00:00,11:30:00\n",
+	})
+
+	_, err := GTFSLoader{Path: path}.Load()
+	assert.ErrorContains(t, err, "99")
+}
+
+// writeGTFSFeed writes files into a zip under t.TempDir and returns its path.
+func writeGTFSFeed(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "feed.zip")
+
+	f, err := os.Create(path)
+	assert.NoError(t, err)
+
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+
+	for name, contents := range files {
+		entry, err := w.Create(name)
+		assert.NoError(t, err)
+
+		_, err = entry.Write([]byte(contents))
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, w.Close())
+
+	return path
+}
+
+func TestResolveStation(t *testing.T) {
+	stationIndex["berlin hbf"] = 1
+
+	assert.Equal(t, "1902", resolveStation("1902"))
+	assert.Equal(t, "1", resolveStation("Berlin Hbf"))
+	assert.Equal(t, "nowhere", resolveStation("nowhere"))
+}
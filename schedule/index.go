@@ -0,0 +1,85 @@
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// stationPair is the (departure, arrival) key trains are indexed by.
+type stationPair struct {
+	departure int
+	arrival   int
+}
+
+// Schedule is a loaded set of trains plus a station-pair index, so a
+// long-running server can load a feed once and serve many queries
+// without re-scanning every train per request.
+type Schedule struct {
+	trains Trains
+	index  map[stationPair]Trains
+}
+
+// LoadSchedule streams path (a JSON array of trains, same shape as
+// data.json) through a json.Decoder rather than reading it fully into
+// memory, and indexes the result by station pair.
+func LoadSchedule(path string) (*Schedule, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.Open returns an error: %v", err)
+	}
+
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+
+	if _, err := dec.Token(); err != nil { // consume the opening '['
+		return nil, fmt.Errorf("read opening token: %w", err)
+	}
+
+	var trains Trains
+
+	for dec.More() {
+		var t Train
+		if err := dec.Decode(&t); err != nil {
+			return nil, fmt.Errorf("decode train: %w", err)
+		}
+
+		trains = append(trains, t)
+	}
+
+	if _, err := dec.Token(); err != nil { // consume the closing ']'
+		return nil, fmt.Errorf("read closing token: %w", err)
+	}
+
+	return newSchedule(trains), nil
+}
+
+func newSchedule(trains Trains) *Schedule {
+	index := make(map[stationPair]Trains, len(trains))
+
+	for _, t := range trains {
+		key := stationPair{departure: t.DepartureStationID, arrival: t.ArrivalStationID}
+		index[key] = append(index[key], t)
+	}
+
+	return &Schedule{trains: trains, index: index}
+}
+
+// Load implements Loader, returning every train in the schedule.
+func (s *Schedule) Load() (Trains, error) {
+	return s.trains, nil
+}
+
+// Find returns the trains between departure and arrival in O(1) average
+// lookup time instead of the O(N) scan selectTrains otherwise falls back
+// to for a plain Loader.
+func (s *Schedule) Find(departure, arrival int) (Trains, error) {
+	return s.index[stationPair{departure: departure, arrival: arrival}], nil
+}
+
+// indexedLoader is implemented by loaders that can answer a station-pair
+// query directly, without selectTrains having to scan every train.
+type indexedLoader interface {
+	Find(departure, arrival int) (Trains, error)
+}
@@ -0,0 +1,78 @@
+package schedule
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+// benchTrains builds n trains spread across sqrt(n) stations, so most
+// station pairs only match a handful of trains - the case an index
+// helps with the most.
+func benchTrains(n int) Trains {
+	trains := make(Trains, n)
+	stations := 1
+
+	for stations*stations < n {
+		stations++
+	}
+
+	base := time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < n; i++ {
+		trains[i] = Train{
+			TrainID:            i,
+			DepartureStationID: i % stations,
+			ArrivalStationID:   (i + 1) % stations,
+			Price:              float32(i % 100),
+			DepartureTime:      base.Add(time.Duration(i) * time.Minute),
+			ArrivalTime:        base.Add(time.Duration(i+30) * time.Minute),
+		}
+	}
+
+	return trains
+}
+
+type benchLoader Trains
+
+func (l benchLoader) Load() (Trains, error) {
+	return Trains(l), nil
+}
+
+func BenchmarkFindTrainsLinearLoader(b *testing.B) {
+	trains := benchTrains(10000)
+
+	prevLoader := activeLoader
+	defer SetLoader(prevLoader)
+	SetLoader(benchLoader(trains))
+
+	dep := strconv.Itoa(trains[len(trains)/2].DepartureStationID)
+	arr := strconv.Itoa(trains[len(trains)/2].ArrivalStationID)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := FindTrains(dep, arr, "price", time.Time{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFindTrainsIndexedSchedule(b *testing.B) {
+	trains := benchTrains(10000)
+
+	prevLoader := activeLoader
+	defer SetLoader(prevLoader)
+	SetLoader(newSchedule(trains))
+
+	dep := strconv.Itoa(trains[len(trains)/2].DepartureStationID)
+	arr := strconv.Itoa(trains[len(trains)/2].ArrivalStationID)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := FindTrains(dep, arr, "price", time.Time{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
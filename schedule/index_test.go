@@ -0,0 +1,36 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSchedule(t *testing.T) {
+	sched, err := LoadSchedule("data.json")
+	assert.NoError(t, err)
+	assert.Len(t, sched.trains, 7)
+
+	trains, err := sched.Find(1902, 1929)
+	assert.NoError(t, err)
+	assert.Len(t, trains, 7)
+
+	trains, err = sched.Find(1902, 9999)
+	assert.NoError(t, err)
+	assert.Empty(t, trains)
+}
+
+func TestFindTrainsUsesScheduleIndex(t *testing.T) {
+	prevLoader := activeLoader
+	defer SetLoader(prevLoader)
+
+	sched, err := LoadSchedule("data.json")
+	assert.NoError(t, err)
+
+	SetLoader(sched)
+
+	result, err := FindTrains("1902", "1929", "price", time.Time{})
+	assert.NoError(t, err)
+	assert.Len(t, result, maxNumberTrainsCondition)
+}
@@ -0,0 +1,231 @@
+package schedule
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// maxJourneyResults mirrors the "top 3" convention FindTrains already uses
+// for single-criteria results.
+const maxJourneyResults = 3
+
+// JourneyOptions constrains how FindJourneys explores transfers.
+type JourneyOptions struct {
+	// MinTransferMinutes is the minimum time a passenger needs between
+	// arriving on one train and departing on the next.
+	MinTransferMinutes int
+	// MaxTransfers caps how many times a journey may change trains.
+	MaxTransfers int
+	// EarliestDeparture is the earliest time the first leg may depart.
+	// The zero value means "no constraint".
+	EarliestDeparture time.Time
+}
+
+// Journey is an ordered sequence of Trains a passenger rides end to end,
+// each leg's ArrivalStationID matching the next leg's DepartureStationID.
+type Journey struct {
+	Legs Trains
+}
+
+// DepartureTime is when the first leg of the journey departs.
+func (j Journey) DepartureTime() time.Time {
+	return j.Legs[0].DepartureTime
+}
+
+// ArrivalTime is when the last leg of the journey arrives.
+func (j Journey) ArrivalTime() time.Time {
+	return j.Legs[len(j.Legs)-1].ArrivalTime
+}
+
+// Transfers is how many times the passenger changes trains.
+func (j Journey) Transfers() int {
+	return len(j.Legs) - 1
+}
+
+// Duration is the wall-clock time from departure to arrival.
+func (j Journey) Duration() time.Duration {
+	return j.ArrivalTime().Sub(j.DepartureTime())
+}
+
+type journeyLabel struct {
+	arrival time.Time
+	set     bool
+}
+
+// FindJourneys plans a trip from departureStation to arrivalStation that
+// may span several trains. It runs a time-dependent Connection Scan: all
+// trains are walked once in departure-time order, and a connection is
+// taken only if it departs no earlier than MinTransferMinutes after the
+// traveller's earliest possible arrival at its departure station.
+func FindJourneys(departureStation, arrivalStation string, opts JourneyOptions) ([]Journey, error) {
+	departureStation = resolveStation(departureStation)
+	arrivalStation = resolveStation(arrivalStation)
+
+	if err := validateEmpty(departureStation); err != nil {
+		return nil, EmptyDepartureErr
+	}
+
+	if err := validateEmpty(arrivalStation); err != nil {
+		return nil, EmptyArrivalErr
+	}
+
+	if err := validateIsNaturalNumber(departureStation); err != nil {
+		return nil, BadDepartureInputErr
+	}
+
+	if err := validateIsNaturalNumber(arrivalStation); err != nil {
+		return nil, BadArrivalInputErr
+	}
+
+	dep, err := strconv.Atoi(departureStation)
+	if err != nil {
+		return nil, BadDepartureInputErr
+	}
+
+	arr, err := strconv.Atoi(arrivalStation)
+	if err != nil {
+		return nil, BadArrivalInputErr
+	}
+
+	maxTransfers := opts.MaxTransfers
+	if maxTransfers < 0 {
+		maxTransfers = 0
+	}
+
+	minTransfer := time.Duration(opts.MinTransferMinutes) * time.Minute
+	if opts.MinTransferMinutes < 0 {
+		minTransfer = 0
+	}
+
+	connections, err := activeLoader.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	connections = filterEarliestDeparture(connections, opts.EarliestDeparture)
+	sort.SliceStable(connections, func(i, j int) bool {
+		return connections[i].DepartureTime.Before(connections[j].DepartureTime)
+	})
+
+	// startTime seeds the traveller's arrival at the departure station.
+	// The feed's customTime parsing yields year-0 timestamps, so the zero
+	// Time{} (year 1) can't double as "no constraint" - it would sort
+	// after every real departure.
+	startTime := opts.EarliestDeparture
+	if startTime.IsZero() {
+		startTime = time.Date(-9999, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	// labels[station][legs] is the earliest arrival at station after
+	// exactly `legs` trains, legs == 0 meaning "already there".
+	labels := map[int][]journeyLabel{}
+	labels[dep] = make([]journeyLabel, maxTransfers+2)
+	labels[dep][0] = journeyLabel{arrival: startTime, set: true}
+
+	type predecessor struct {
+		station int
+		legs    int
+		connIdx int
+	}
+
+	pred := map[int]map[int]predecessor{}
+
+	for connIdx, conn := range connections {
+		fromLabels := labels[conn.DepartureStationID]
+		if fromLabels == nil {
+			continue
+		}
+
+		for legs := 0; legs <= maxTransfers; legs++ {
+			from := fromLabels[legs]
+			if !from.set {
+				continue
+			}
+
+			if conn.DepartureTime.Before(from.arrival.Add(minTransfer)) {
+				continue
+			}
+
+			toLabels := labels[conn.ArrivalStationID]
+			if toLabels == nil {
+				toLabels = make([]journeyLabel, maxTransfers+2)
+				labels[conn.ArrivalStationID] = toLabels
+			}
+
+			nextLegs := legs + 1
+			if toLabels[nextLegs].set && !conn.ArrivalTime.Before(toLabels[nextLegs].arrival) {
+				continue
+			}
+
+			toLabels[nextLegs] = journeyLabel{arrival: conn.ArrivalTime, set: true}
+
+			if pred[conn.ArrivalStationID] == nil {
+				pred[conn.ArrivalStationID] = map[int]predecessor{}
+			}
+
+			pred[conn.ArrivalStationID][nextLegs] = predecessor{
+				station: conn.DepartureStationID,
+				legs:    legs,
+				connIdx: connIdx,
+			}
+		}
+	}
+
+	arrLabels := labels[arr]
+	if arrLabels == nil {
+		return nil, nil
+	}
+
+	var journeys []Journey
+
+	for legs := 1; legs <= maxTransfers+1; legs++ {
+		if !arrLabels[legs].set {
+			continue
+		}
+
+		var legsOut Trains
+
+		station, remaining := arr, legs
+
+		for remaining > 0 {
+			p, ok := pred[station][remaining]
+			if !ok {
+				break
+			}
+
+			legsOut = append(Trains{connections[p.connIdx]}, legsOut...)
+			station, remaining = p.station, p.legs
+		}
+
+		if len(legsOut) == legs {
+			journeys = append(journeys, Journey{Legs: legsOut})
+		}
+	}
+
+	sort.SliceStable(journeys, func(i, j int) bool {
+		return journeys[i].Duration() < journeys[j].Duration()
+	})
+
+	if len(journeys) > maxJourneyResults {
+		journeys = journeys[:maxJourneyResults]
+	}
+
+	return journeys, nil
+}
+
+func filterEarliestDeparture(trains Trains, earliest time.Time) Trains {
+	if earliest.IsZero() {
+		return trains
+	}
+
+	var filtered Trains
+
+	for _, t := range trains {
+		if !t.DepartureTime.Before(earliest) {
+			filtered = append(filtered, t)
+		}
+	}
+
+	return filtered
+}
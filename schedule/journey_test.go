@@ -0,0 +1,56 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type staticLoader Trains
+
+func (l staticLoader) Load() (Trains, error) {
+	return Trains(l), nil
+}
+
+func TestFindJourneysWithTransfer(t *testing.T) {
+	prevLoader := activeLoader
+	defer SetLoader(prevLoader)
+
+	SetLoader(staticLoader{
+		{TrainID: 1, DepartureStationID: 1, ArrivalStationID: 2,
+			DepartureTime: time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC),
+			ArrivalTime:   time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC)},
+		{TrainID: 2, DepartureStationID: 2, ArrivalStationID: 3,
+			DepartureTime: time.Date(0, 1, 1, 9, 20, 0, 0, time.UTC),
+			ArrivalTime:   time.Date(0, 1, 1, 10, 30, 0, 0, time.UTC)},
+		{TrainID: 3, DepartureStationID: 2, ArrivalStationID: 3,
+			DepartureTime: time.Date(0, 1, 1, 9, 5, 0, 0, time.UTC),
+			ArrivalTime:   time.Date(0, 1, 1, 10, 15, 0, 0, time.UTC)},
+	})
+
+	journeys, err := FindJourneys("1", "3", JourneyOptions{MinTransferMinutes: 10, MaxTransfers: 1})
+	assert.NoError(t, err)
+	assert.Len(t, journeys, 1)
+	assert.Equal(t, []int{1, 2}, []int{journeys[0].Legs[0].TrainID, journeys[0].Legs[1].TrainID})
+	assert.Equal(t, 1, journeys[0].Transfers())
+}
+
+func TestFindJourneysNoTransfersAllowed(t *testing.T) {
+	prevLoader := activeLoader
+	defer SetLoader(prevLoader)
+
+	SetLoader(staticLoader{
+		{TrainID: 1, DepartureStationID: 1, ArrivalStationID: 2,
+			DepartureTime: time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC),
+			ArrivalTime:   time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC)},
+	})
+
+	journeys, err := FindJourneys("1", "2", JourneyOptions{MaxTransfers: 0})
+	assert.NoError(t, err)
+	assert.Len(t, journeys, 1)
+
+	journeys, err = FindJourneys("1", "3", JourneyOptions{MaxTransfers: 0})
+	assert.NoError(t, err)
+	assert.Empty(t, journeys)
+}
@@ -0,0 +1,474 @@
+// Package schedule holds the train-search core: loading the schedule,
+// validating queries and selecting/sorting matching trains. It has no
+// knowledge of how it is invoked (CLI, HTTP, ...) so it can be shared by
+// every front end.
+package schedule
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	fileName   = "data.json"
+	timeLayout = "15:04:05"
+)
+
+const (
+	maxNumberTrainsCondition = 3
+	naturalNumberCondition   = 0
+	sortCondition            = 1
+)
+
+type сriteriaMap map[string]struct{}
+
+// Trains is a collection of Train records.
+type Trains []Train
+
+type customTime time.Time
+
+// UnmarshalJSON Parses the json string in the custom format, falling back
+// to RFC3339 for feeds that ship full timestamps instead of a bare
+// time-of-day.
+func (ct *customTime) UnmarshalJSON(b []byte) (err error) {
+	s := strings.Trim(string(b), `"`)
+
+	nt, err := time.Parse(timeLayout, s)
+	if err != nil {
+		nt, err = time.Parse(time.RFC3339, s)
+	}
+
+	*ct = customTime(nt)
+
+	return
+}
+
+// Train describes a single scheduled trip between two stations.
+type Train struct {
+	TrainID            int         `json:"trainId"`
+	DepartureStationID int         `json:"departureStationId"`
+	ArrivalStationID   int         `json:"arrivalStationId"`
+	Price              float32     `json:"price"`
+	ArrivalTime        time.Time   `json:"arrivalTime"`
+	DepartureTime      time.Time   `json:"departureTime"`
+	ServiceDays        ServiceDays `json:"serviceDays,omitempty"`
+}
+
+func (t *Train) UnmarshalJSON(data []byte) error {
+	var aux struct { //aux means auxiliary
+		TrainID            int
+		DepartureStationID int
+		ArrivalStationID   int
+		Price              float32
+		ArrivalTime        customTime
+		DepartureTime      customTime
+		ServiceDays        ServiceDays
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(&aux); err != nil {
+		return fmt.Errorf("decode train: %v", err)
+	}
+
+	t.TrainID = aux.TrainID
+	t.DepartureStationID = aux.DepartureStationID
+	t.ArrivalStationID = aux.ArrivalStationID
+	t.Price = aux.Price
+	t.ArrivalTime = time.Time(aux.ArrivalTime)
+	t.DepartureTime = time.Time(aux.DepartureTime)
+	t.ServiceDays = aux.ServiceDays
+
+	// A bare time-of-day parses onto year 0 for both fields, so an
+	// overnight train (e.g. departs 23:50, arrives 00:40) rolls its
+	// arrival onto the next day instead of ending up before its own
+	// departure.
+	if t.ArrivalTime.Before(t.DepartureTime) {
+		t.ArrivalTime = t.ArrivalTime.AddDate(0, 0, 1)
+	}
+
+	return nil
+}
+
+// String returns the train in the custom format
+func (t Train) String() string {
+	output := fmt.Sprintf("TrainID \t DepartureStationID \t\t ArrivalStationID \t Price \t\t\t "+
+		"ArrivalTime \t\t DepartureTime \n %v\t\t %v\t\t\t\t %v\t\t\t %v\t\t\t %v\t\t %v", t.TrainID,
+		t.DepartureStationID, t.ArrivalStationID, t.Price, t.ArrivalTime.Format(timeLayout),
+		t.DepartureTime.Format(timeLayout))
+
+	return output
+}
+
+var (
+	CriteriaErr          = errors.New("unsupported criteria")
+	EmptyDepartureErr    = errors.New("empty departure station")
+	EmptyArrivalErr      = errors.New("empty arrival station")
+	BadArrivalInputErr   = errors.New("bad arrival station input")
+	BadDepartureInputErr = errors.New("bad departure station input")
+)
+
+var validCriteria = сriteriaMap{
+	"price":          {},
+	"arrival-time":   {},
+	"departure-time": {},
+}
+
+// paretoCriteria requests the Pareto frontier instead of a sort.
+const paretoCriteria = "pareto"
+
+// Loader builds the in-memory train schedule from some source (a JSON
+// file, a GTFS feed, ...). Implementations are interchangeable: FindTrains
+// only ever talks to the active Loader.
+type Loader interface {
+	Load() (Trains, error)
+}
+
+// activeLoader is the Loader consulted by FindTrains and friends. It
+// defaults to reading the JSON fileName, matching the tool's original
+// behaviour; call SetLoader to point it at a different feed.
+var activeLoader Loader = JSONLoader{Path: fileName}
+
+// SetLoader replaces the Loader used for subsequent queries.
+func SetLoader(l Loader) {
+	activeLoader = l
+}
+
+// JSONLoader reads the schedule from the original flat JSON format.
+type JSONLoader struct {
+	Path string
+}
+
+// Load implements Loader.
+func (l JSONLoader) Load() (Trains, error) {
+	jsonFile, err := os.Open(l.Path)
+	if err != nil {
+		return nil, fmt.Errorf("os.Open returns an error: %v", err)
+	}
+
+	defer jsonFile.Close()
+
+	byteValue, _ := ioutil.ReadAll(jsonFile)
+
+	var trainSchedule []Train
+	if err := json.Unmarshal(byteValue, &trainSchedule); err != nil {
+		return nil, fmt.Errorf("error during Unmarshal: %v", err)
+	}
+
+	return trainSchedule, nil
+}
+
+// stationIndex maps a lowercased station name to its numeric station ID,
+// populated by loaders (such as GTFSLoader) that know station names.
+var stationIndex = map[string]int{}
+
+// resolveStation turns a station name into the numeric ID FindTrains
+// expects. Inputs that already look like an ID are returned unchanged, so
+// existing numeric-only callers keep working.
+func resolveStation(s string) string {
+	if _, err := strconv.Atoi(s); err == nil {
+		return s
+	}
+
+	if id, ok := stationIndex[strings.ToLower(s)]; ok {
+		return strconv.Itoa(id)
+	}
+
+	return s
+}
+
+// FindTrains validates the query, selects the matching trains and returns
+// the top results ordered by criteria. A zero date means "any date",
+// matching the tool's original date-agnostic behaviour; a non-zero date
+// restricts results to trains whose ServiceDays run on that date.
+func FindTrains(departureStation, arrivalStation, criteria string, date time.Time) (Trains, error) {
+	return FindTrainsLimit(departureStation, arrivalStation, criteria, date, maxNumberTrainsCondition)
+}
+
+// FindTrainsLimit is FindTrains with the result cap under the caller's
+// control instead of hard-coded to 3, so HTTP callers can ask for more
+// (or fewer) results via ?limit=. A negative limit falls back to
+// FindTrains' default of 3; it is otherwise ignored for criteria=pareto,
+// whose whole point is returning the complete frontier.
+func FindTrainsLimit(departureStation, arrivalStation, criteria string, date time.Time, limit int) (Trains, error) {
+	if limit < 0 {
+		limit = maxNumberTrainsCondition
+	}
+
+	departureStation = resolveStation(departureStation)
+	arrivalStation = resolveStation(arrivalStation)
+
+	if err := validator(departureStation, arrivalStation, criteria); err != nil {
+		return nil, err
+	}
+
+	availableTrains, err := selectTrains(departureStation, arrivalStation, date)
+	if err != nil {
+		return nil, fmt.Errorf("selectTrains failed: %w", err)
+	}
+
+	if len(availableTrains) < sortCondition {
+		return availableTrains, nil
+	}
+
+	sortedTrains := sortTrains(availableTrains, criteria)
+
+	if criteria != paretoCriteria && len(sortedTrains) > limit {
+		sortedTrains = sortedTrains[:limit]
+	}
+
+	return sortedTrains, nil
+}
+
+func validator(departureStation, arrivalStation, criteria string) error {
+	if err := validateEmpty(departureStation); err != nil {
+		return EmptyDepartureErr
+	}
+
+	if err := validateEmpty(arrivalStation); err != nil {
+		return EmptyArrivalErr
+	}
+
+	if err := validateIsNaturalNumber(departureStation); err != nil {
+		return BadDepartureInputErr
+	}
+
+	if err := validateIsNaturalNumber(arrivalStation); err != nil {
+		return BadArrivalInputErr
+	}
+
+	if err := validateCriteria(criteria); err != nil {
+		return CriteriaErr
+	}
+
+	return nil
+}
+
+// validateCriteria accepts the original single-key criteria ("price"),
+// "pareto", or a comma-separated lexicographic tie-breaker list such as
+// "price,arrival-time", where every key must be one of validCriteria.
+func validateCriteria(criteria string) error {
+	if criteria == paretoCriteria {
+		return nil
+	}
+
+	keys := strings.Split(criteria, ",")
+	if len(keys) == 0 {
+		return CriteriaErr
+	}
+
+	for _, key := range keys {
+		if _, ok := validCriteria[key]; !ok {
+			return CriteriaErr
+		}
+	}
+
+	return nil
+}
+
+func validateEmpty(s string) error {
+	if len(s) == 0 { // if len = 0 then string is empty
+		return fmt.Errorf("value of input is empty")
+	}
+
+	return nil
+}
+
+func validateIsNaturalNumber(s string) error {
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("can't convert value to int: %w", err)
+	}
+
+	if value <= naturalNumberCondition {
+		return fmt.Errorf("value is not a natural number")
+	}
+
+	return nil
+}
+
+func selectTrains(departureStation, arrivalStation string, date time.Time) (Trains, error) {
+	departure, err := strconv.Atoi(departureStation)
+	if err != nil {
+		return nil, fmt.Errorf("can't convert departureStation to int: %w", err)
+	}
+
+	arrival, err := strconv.Atoi(arrivalStation)
+	if err != nil {
+		return nil, fmt.Errorf("can't convert arrivalStation to int: %w", err)
+	}
+
+	candidates, err := candidateTrains(departure, arrival)
+	if err != nil {
+		return nil, err
+	}
+
+	var availableTrains Trains
+
+	for _, v := range candidates {
+		if !date.IsZero() && !v.ServiceDays.Runs(date) {
+			continue
+		}
+
+		availableTrains = append(availableTrains, v)
+	}
+
+	return availableTrains, nil
+}
+
+// candidateTrains returns every train between departure and arrival,
+// using the active loader's index when it has one (O(1) average) and
+// falling back to a full scan of Load() otherwise.
+func candidateTrains(departure, arrival int) (Trains, error) {
+	if il, ok := activeLoader.(indexedLoader); ok {
+		return il.Find(departure, arrival)
+	}
+
+	trainSchedule, err := activeLoader.Load()
+	if err != nil {
+		return nil, fmt.Errorf("loader failed: %w", err)
+	}
+
+	var matches Trains
+
+	for _, v := range trainSchedule {
+		if v.DepartureStationID == departure && v.ArrivalStationID == arrival {
+			matches = append(matches, v)
+		}
+	}
+
+	return matches, nil
+}
+
+// criteriaLess maps a single criteria key to a "less" comparator over
+// Trains, shared by both the single-key path and the lexicographic
+// tie-breaker path.
+var criteriaLess = map[string]func(a, b Train) bool{
+	"price": func(a, b Train) bool {
+		return a.Price < b.Price
+	},
+	"arrival-time": func(a, b Train) bool {
+		return a.ArrivalTime.Before(b.ArrivalTime)
+	},
+	"departure-time": func(a, b Train) bool {
+		return a.DepartureTime.Before(b.DepartureTime)
+	},
+}
+
+func sortTrains(availableTrains Trains, criteria string) Trains {
+	if criteria == paretoCriteria {
+		return paretoFrontier(availableTrains)
+	}
+
+	return sortTrainsByKeys(availableTrains, strings.Split(criteria, ","))
+}
+
+// sortTrainsByKeys orders availableTrains lexicographically by keys,
+// keys[0] being the primary key. Each key is applied with sort.SliceStable
+// in reverse order, which is the standard way to build a lexicographic
+// sort out of repeated stable single-key sorts.
+func sortTrainsByKeys(availableTrains Trains, keys []string) Trains {
+	for i := len(keys) - 1; i >= 0; i-- {
+		less := criteriaLess[keys[i]]
+		sort.SliceStable(availableTrains, func(a, b int) bool {
+			return less(availableTrains[a], availableTrains[b])
+		})
+	}
+
+	return availableTrains
+}
+
+func sortTrainsByDeparture(availableTrains Trains) Trains {
+	return sortTrainsByKeys(availableTrains, []string{"departure-time"})
+}
+
+// paretoFrontier keeps only the trains not dominated by another train on
+// price, arrival time, departure time and duration.
+func paretoFrontier(availableTrains Trains) Trains {
+	var frontier Trains
+
+	for i, candidate := range availableTrains {
+		dominated := false
+
+		for j, other := range availableTrains {
+			if i == j {
+				continue
+			}
+
+			if trainDominates(other, candidate) {
+				dominated = true
+				break
+			}
+		}
+
+		if !dominated {
+			frontier = append(frontier, candidate)
+		}
+	}
+
+	return frontier
+}
+
+// trainDominates reports whether a dominates b: no worse on every axis and
+// strictly better on at least one.
+func trainDominates(a, b Train) bool {
+	aDuration := a.ArrivalTime.Sub(a.DepartureTime)
+	bDuration := b.ArrivalTime.Sub(b.DepartureTime)
+
+	noWorse := a.Price <= b.Price &&
+		!a.ArrivalTime.After(b.ArrivalTime) &&
+		!a.DepartureTime.After(b.DepartureTime) &&
+		aDuration <= bDuration
+	if !noWorse {
+		return false
+	}
+
+	return a.Price < b.Price ||
+		a.ArrivalTime.Before(b.ArrivalTime) ||
+		a.DepartureTime.Before(b.DepartureTime) ||
+		aDuration < bDuration
+}
+
+// FindTrainByID returns a single train by its ID, or nil if no such train
+// exists in the schedule.
+func FindTrainByID(trainID int) (*Train, error) {
+	trainSchedule, err := activeLoader.Load()
+	if err != nil {
+		return nil, fmt.Errorf("loader failed: %w", err)
+	}
+
+	for _, v := range trainSchedule {
+		if v.TrainID == trainID {
+			t := v
+			return &t, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// FindDepartures returns every train departing from the given station,
+// sorted by departure time.
+func FindDepartures(stationID int) (Trains, error) {
+	trainSchedule, err := activeLoader.Load()
+	if err != nil {
+		return nil, fmt.Errorf("loader failed: %w", err)
+	}
+
+	var departures Trains
+
+	for _, v := range trainSchedule {
+		if v.DepartureStationID == stationID {
+			departures = append(departures, v)
+		}
+	}
+
+	return sortTrainsByDeparture(departures), nil
+}
@@ -0,0 +1,146 @@
+// Package server exposes the schedule core over HTTP so the train search
+// can be run as a long-lived service instead of an interactive prompt.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vdshakh/summer-2022-practice-task/schedule"
+)
+
+const dateLayout = "2006-01-02"
+
+// NewRouter builds the HTTP routes backing the train-search API.
+func NewRouter() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/trains", handleTrains)
+	mux.HandleFunc("/trains/", handleTrain)
+	mux.HandleFunc("/stations/", handleStationDepartures)
+
+	return mux
+}
+
+func handleTrains(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	q := r.URL.Query()
+
+	var date time.Time
+
+	if raw := q.Get("date"); raw != "" {
+		var err error
+
+		date, err = time.Parse(dateLayout, raw)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errors.New("bad date input"))
+			return
+		}
+	}
+
+	limit := -1
+
+	if raw := q.Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, errors.New("bad limit input"))
+			return
+		}
+
+		limit = n
+	}
+
+	result, err := schedule.FindTrainsLimit(q.Get("departure"), q.Get("arrival"), q.Get("criteria"), date, limit)
+	if err != nil {
+		writeError(w, statusForErr(err), err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func handleTrain(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	id, err := strconv.Atoi(r.URL.Path[len("/trains/"):])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("bad train id"))
+		return
+	}
+
+	train, err := schedule.FindTrainByID(id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if train == nil {
+		writeError(w, http.StatusNotFound, errors.New("train not found"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, train)
+}
+
+func handleStationDepartures(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	path := r.URL.Path[len("/stations/"):]
+
+	const suffix = "/departures"
+	if len(path) <= len(suffix) || path[len(path)-len(suffix):] != suffix {
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	stationID, err := strconv.Atoi(path[:len(path)-len(suffix)])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.New("bad station id"))
+		return
+	}
+
+	departures, err := schedule.FindDepartures(stationID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, departures)
+}
+
+// statusForErr maps the schedule package's sentinel errors to the HTTP
+// status code that best describes them.
+func statusForErr(err error) int {
+	switch {
+	case errors.Is(err, schedule.EmptyDepartureErr),
+		errors.Is(err, schedule.EmptyArrivalErr),
+		errors.Is(err, schedule.BadDepartureInputErr),
+		errors.Is(err, schedule.BadArrivalInputErr),
+		errors.Is(err, schedule.CriteriaErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
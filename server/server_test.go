@@ -0,0 +1,63 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vdshakh/summer-2022-practice-task/schedule"
+)
+
+type staticLoader schedule.Trains
+
+func (l staticLoader) Load() (schedule.Trains, error) {
+	return schedule.Trains(l), nil
+}
+
+func manyTrains(n int) schedule.Trains {
+	trains := make(schedule.Trains, n)
+	base := time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < n; i++ {
+		trains[i] = schedule.Train{
+			TrainID:            i,
+			DepartureStationID: 1,
+			ArrivalStationID:   2,
+			Price:              float32(i),
+			DepartureTime:      base,
+			ArrivalTime:        base.Add(time.Hour),
+		}
+	}
+
+	return trains
+}
+
+func TestHandleTrainsRejectsNegativeLimit(t *testing.T) {
+	schedule.SetLoader(staticLoader(manyTrains(5)))
+
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/trains?departure=1&arrival=2&criteria=price&limit=-1", nil)
+
+	NewRouter().ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandleTrainsLimitCanExceedDefaultCap(t *testing.T) {
+	schedule.SetLoader(staticLoader(manyTrains(5)))
+
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/trains?departure=1&arrival=2&criteria=price&limit=5", nil)
+
+	NewRouter().ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var result schedule.Trains
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &result))
+	assert.Len(t, result, 5)
+}